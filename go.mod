@@ -4,6 +4,7 @@ go 1.24.1
 
 require (
 	github.com/go-telegram-bot-api/telegram-bot-api/v5 v5.5.1
+	github.com/klauspost/compress v1.18.0
 	github.com/oschwald/geoip2-golang v1.13.0
 	golang.org/x/crypto v0.40.0
 	golang.org/x/net v0.42.0